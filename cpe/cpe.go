@@ -0,0 +1,189 @@
+// Package cpe parses and matches CPE 2.3 formatted names as defined by
+// NIST IR 7695 (https://nvlpubs.nist.gov/nistpubs/Legacy/IR/nistir7695.pdf).
+package cpe
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Any matches any value of a component, including unspecified.
+const Any = "*"
+
+// NA marks a component as explicitly not applicable.
+const NA = "-"
+
+// Name is a parsed "cpe:2.3:..." formatted name, broken into its 11 defined
+// components. Unbound components are the empty string, which compares the
+// same as Any.
+type Name struct {
+	Part      string
+	Vendor    string
+	Product   string
+	Version   string
+	Update    string
+	Edition   string
+	Language  string
+	SWEdition string
+	TargetSW  string
+	TargetHW  string
+	Other     string
+}
+
+// Parse decodes a "cpe:2.3:part:vendor:product:version:update:edition:
+// language:sw_edition:target_sw:target_hw:other" formatted name.
+func Parse(s string) (Name, error) {
+	parts := splitUnescaped(s, ':')
+	if len(parts) != 13 || parts[0] != "cpe" || parts[1] != "2.3" {
+		return Name{}, fmt.Errorf("cpe: not a well-formed CPE 2.3 name: %q", s)
+	}
+	return Name{
+		Part:      parts[2],
+		Vendor:    parts[3],
+		Product:   parts[4],
+		Version:   parts[5],
+		Update:    parts[6],
+		Edition:   parts[7],
+		Language:  parts[8],
+		SWEdition: parts[9],
+		TargetSW:  parts[10],
+		TargetHW:  parts[11],
+		Other:     parts[12],
+	}, nil
+}
+
+// splitUnescaped splits on sep, treating a backslash-escaped sep as a
+// literal character rather than a delimiter.
+func splitUnescaped(s string, sep byte) []string {
+	var out []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			out = append(out, s[start:i])
+			start = i + 1
+		}
+	}
+	out = append(out, s[start:])
+	return out
+}
+
+// Matches reports whether candidate satisfies pattern per CPE 2.3 matching
+// rules: a pattern component of ANY (or unspecified) matches anything, NA
+// only matches NA, and other components support "*"/"?" wildcards within an
+// otherwise literal comparison.
+func (pattern Name) Matches(candidate Name) bool {
+	return matchComponent(pattern.Part, candidate.Part) &&
+		matchComponent(pattern.Vendor, candidate.Vendor) &&
+		matchComponent(pattern.Product, candidate.Product) &&
+		matchComponent(pattern.Version, candidate.Version) &&
+		matchComponent(pattern.Update, candidate.Update) &&
+		matchComponent(pattern.Edition, candidate.Edition) &&
+		matchComponent(pattern.Language, candidate.Language) &&
+		matchComponent(pattern.SWEdition, candidate.SWEdition) &&
+		matchComponent(pattern.TargetSW, candidate.TargetSW) &&
+		matchComponent(pattern.TargetHW, candidate.TargetHW) &&
+		matchComponent(pattern.Other, candidate.Other)
+}
+
+func matchComponent(pattern, candidate string) bool {
+	if pattern == "" || pattern == Any {
+		return true
+	}
+	if pattern == NA {
+		return candidate == NA
+	}
+	if candidate == Any || candidate == NA || candidate == "" {
+		return false
+	}
+	// candidate is a WFN-bound string: any backslash in it is escaping a
+	// literal special character (including a literal "*" or "?"), not
+	// introducing a wildcard, so it's unescaped before the byte-for-byte
+	// comparison in globMatch.
+	return globMatch(tokenizePattern(strings.ToLower(pattern)), unescape(strings.ToLower(candidate)))
+}
+
+// unescape strips the backslashes CPE 2.3 uses to escape special
+// characters in a bound (non-pattern) component.
+func unescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// patElemKind distinguishes a pattern token from a literal character that
+// happens to be the wildcard byte, e.g. an escaped "\*".
+type patElemKind byte
+
+const (
+	litElem      patElemKind = 'l'
+	starElem     patElemKind = '*'
+	questionElem patElemKind = '?'
+)
+
+type patElem struct {
+	kind patElemKind
+	ch   byte // valid when kind == litElem
+}
+
+// tokenizePattern turns a CPE component into wildcard/literal tokens,
+// treating a backslash-escaped "*" or "?" as the literal character rather
+// than a wildcard.
+func tokenizePattern(pattern string) []patElem {
+	elems := make([]patElem, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\\' && i+1 < len(pattern) {
+			i++
+			elems = append(elems, patElem{kind: litElem, ch: pattern[i]})
+			continue
+		}
+		switch c {
+		case '*':
+			elems = append(elems, patElem{kind: starElem})
+		case '?':
+			elems = append(elems, patElem{kind: questionElem})
+		default:
+			elems = append(elems, patElem{kind: litElem, ch: c})
+		}
+	}
+	return elems
+}
+
+// globMatch matches CPE's "*" (zero or more chars) and "?" (exactly one
+// char) wildcards against an otherwise literal comparison. Callers are
+// expected to have already case-folded both pattern and s.
+func globMatch(pattern []patElem, s string) bool {
+	// Classic DP glob match; CPE patterns are short so this is cheap.
+	m, n := len(pattern), len(s)
+	dp := make([][]bool, m+1)
+	for i := range dp {
+		dp[i] = make([]bool, n+1)
+	}
+	dp[0][0] = true
+	for i := 1; i <= m; i++ {
+		if pattern[i-1].kind == starElem {
+			dp[i][0] = dp[i-1][0]
+		}
+	}
+	for i := 1; i <= m; i++ {
+		for j := 1; j <= n; j++ {
+			switch pattern[i-1].kind {
+			case starElem:
+				dp[i][j] = dp[i-1][j] || dp[i][j-1]
+			case questionElem:
+				dp[i][j] = dp[i-1][j-1]
+			default:
+				dp[i][j] = dp[i-1][j-1] && pattern[i-1].ch == s[j-1]
+			}
+		}
+	}
+	return dp[m][n]
+}
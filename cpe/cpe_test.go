@@ -0,0 +1,129 @@
+package cpe
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	name, err := Parse("cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := Name{
+		Part: "a", Vendor: "apache", Product: "log4j", Version: "2.14.1",
+		Update: "*", Edition: "*", Language: "*", SWEdition: "*",
+		TargetSW: "*", TargetHW: "*", Other: "*",
+	}
+	if name != want {
+		t.Fatalf("Parse got %+v, want %+v", name, want)
+	}
+}
+
+func TestParseRejectsMalformed(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"cpe:2.3:a:apache:log4j", // too few components
+		"not-a-cpe-string",
+		"cpe:2.2:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", // wrong version
+	} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q): want error, got nil", s)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		candidate string
+		want      bool
+	}{
+		{
+			name:      "exact match",
+			pattern:   "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "ANY pattern component matches any candidate value",
+			pattern:   "cpe:2.3:a:apache:*:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "NA pattern only matches NA candidate",
+			pattern:   "cpe:2.3:a:apache:log4j:-:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      false,
+		},
+		{
+			name:      "NA pattern matches NA candidate",
+			pattern:   "cpe:2.3:a:apache:log4j:-:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:-:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "candidate ANY never satisfies a literal pattern",
+			pattern:   "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*",
+			want:      false,
+		},
+		{
+			name:      "case-insensitive vendor/product",
+			pattern:   "cpe:2.3:a:Apache:Log4J:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "wildcard * matches substring",
+			pattern:   "cpe:2.3:a:apache:log*:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "wildcard ? matches exactly one char",
+			pattern:   "cpe:2.3:a:apache:log4?:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      true,
+		},
+		{
+			name:      "wildcard ? does not match zero chars",
+			pattern:   "cpe:2.3:a:apache:log4j?:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      false,
+		},
+		{
+			name:      "escaped asterisk is literal, not a wildcard",
+			pattern:   `cpe:2.3:a:apache:log4j\*:2.14.1:*:*:*:*:*:*:*`,
+			candidate: "cpe:2.3:a:apache:log4jX:2.14.1:*:*:*:*:*:*:*",
+			want:      false,
+		},
+		{
+			name:      "escaped asterisk matches the literal asterisk",
+			pattern:   `cpe:2.3:a:apache:log4j\*:2.14.1:*:*:*:*:*:*:*`,
+			candidate: `cpe:2.3:a:apache:log4j\*:2.14.1:*:*:*:*:*:*:*`,
+			want:      true,
+		},
+		{
+			name:      "mismatched vendor",
+			pattern:   "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*",
+			candidate: "cpe:2.3:a:microsoft:log4j:2.14.1:*:*:*:*:*:*:*",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := Parse(tt.pattern)
+			if err != nil {
+				t.Fatalf("Parse(pattern): %v", err)
+			}
+			candidate, err := Parse(tt.candidate)
+			if err != nil {
+				t.Fatalf("Parse(candidate): %v", err)
+			}
+			if got := pattern.Matches(candidate); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
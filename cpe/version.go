@@ -0,0 +1,54 @@
+package cpe
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compare orders two CPE version strings. It is semver-tolerant but not
+// semver-strict: versions are split into dot/dash/underscore separated
+// segments, each compared numerically if both sides parse as integers and
+// lexically (case-insensitive) otherwise, so versions like "1.2.3",
+// "10.0", and "2019.1a" all compare sensibly. It returns -1, 0, or 1.
+func Compare(a, b string) int {
+	as := splitVersion(a)
+	bs := splitVersion(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y string
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		if c := compareSegment(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// LessThan reports whether a < b per Compare.
+func LessThan(a, b string) bool { return Compare(a, b) < 0 }
+
+func splitVersion(v string) []string {
+	return strings.FieldsFunc(v, func(r rune) bool {
+		return r == '.' || r == '-' || r == '_'
+	})
+}
+
+func compareSegment(x, y string) int {
+	xn, xerr := strconv.Atoi(x)
+	yn, yerr := strconv.Atoi(y)
+	if xerr == nil && yerr == nil {
+		switch {
+		case xn < yn:
+			return -1
+		case xn > yn:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+}
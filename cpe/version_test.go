@@ -0,0 +1,34 @@
+package cpe
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.2.4", "1.2.3", 1},
+		{"1.9", "1.10", -1}, // numeric, not lexical, comparison
+		{"2019.1a", "2019.1b", -1},
+		{"1.0", "1.0.0", -1}, // shorter version sorts before a more specific one
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLessThan(t *testing.T) {
+	if !LessThan("1.2.3", "1.2.4") {
+		t.Error("LessThan(1.2.3, 1.2.4) = false, want true")
+	}
+	if LessThan("1.2.4", "1.2.3") {
+		t.Error("LessThan(1.2.4, 1.2.3) = true, want false")
+	}
+	if LessThan("1.2.3", "1.2.3") {
+		t.Error("LessThan(1.2.3, 1.2.3) = true, want false")
+	}
+}
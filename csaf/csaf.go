@@ -0,0 +1,102 @@
+// Package csaf defines the subset of the CSAF 2.0 VEX schema
+// (https://docs.oasis-open.org/csaf/csaf/v2.0/csaf-v2.0.html) that
+// nvdnotifier can populate from an NVD CVE record.
+package csaf
+
+// Document is a CSAF 2.0 document.
+type Document struct {
+	Document        DocumentMeta    `json:"document"`
+	ProductTree     ProductTree     `json:"product_tree,omitempty"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// DocumentMeta is CSAF's top-level /document object.
+type DocumentMeta struct {
+	Category    string    `json:"category"`
+	CSAFVersion string    `json:"csaf_version"`
+	Title       string    `json:"title"`
+	Tracking    Tracking  `json:"tracking"`
+	Publisher   Publisher `json:"publisher"`
+}
+
+// Tracking is CSAF's /document/tracking object. RevisionHistory is
+// mandatory in the CSAF 2.0 schema (minItems 1); a document with none will
+// fail validation against the published profiles.
+type Tracking struct {
+	ID                 string          `json:"id"`
+	Status             string          `json:"status"`
+	Version            string          `json:"version"`
+	InitialReleaseDate string          `json:"initial_release_date"`
+	CurrentReleaseDate string          `json:"current_release_date"`
+	RevisionHistory    []RevisionEntry `json:"revision_history"`
+}
+
+// RevisionEntry is one entry in /document/tracking/revision_history.
+type RevisionEntry struct {
+	Date    string `json:"date"`
+	Number  string `json:"number"`
+	Summary string `json:"summary"`
+}
+
+// Publisher is CSAF's /document/publisher object.
+type Publisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// ProductTree is CSAF's /product_tree object.
+type ProductTree struct {
+	FullProductNames []FullProductName `json:"full_product_names,omitempty"`
+}
+
+// FullProductName names one product referenced elsewhere in the document
+// by ProductID.
+type FullProductName struct {
+	ProductID string `json:"product_id"`
+	Name      string `json:"name"`
+}
+
+// Vulnerability is one entry in CSAF's /vulnerabilities array.
+type Vulnerability struct {
+	CVE    string  `json:"cve"`
+	Notes  []Note  `json:"notes,omitempty"`
+	Scores []Score `json:"scores,omitempty"`
+	// ProductStatus is a pointer, not a value, because CSAF requires at
+	// least one property set when it's present at all; "omitempty" is a
+	// no-op on a struct field, so a pointer is the only way to omit it.
+	ProductStatus *ProductStatus `json:"product_status,omitempty"`
+	References    []Reference    `json:"references,omitempty"`
+}
+
+// Note is a free-text annotation, e.g. the CVE description.
+type Note struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+}
+
+// Score carries a CVSS vector for the affected products it names.
+type Score struct {
+	Products []string `json:"products"`
+	CVSSV3   *CVSSV3  `json:"cvss_v3,omitempty"`
+}
+
+// CVSSV3 is the subset of CVSS v3.1 fields CSAF expects inline.
+type CVSSV3 struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity"`
+}
+
+// ProductStatus lists which products are known affected/fixed/etc.
+type ProductStatus struct {
+	KnownAffected []string `json:"known_affected,omitempty"`
+}
+
+// Reference is a link related to the vulnerability.
+type Reference struct {
+	Category string `json:"category"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url"`
+}
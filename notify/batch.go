@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Batcher coalesces items passed to Notify over Window into a single
+// downstream delivery, so a high-volume day sends one digest instead of a
+// message per CVE. Flush (or the window elapsing) sends whatever has
+// accumulated so far; a zero Window flushes on every call instead.
+type Batcher struct {
+	Notifier Notifier
+	Window   time.Duration
+	// MaxBatch flushes early once this many items have accumulated, even
+	// if Window hasn't elapsed. Zero means no limit.
+	MaxBatch int
+
+	mu      sync.Mutex
+	pending []nvd.CVEItem
+	timer   *time.Timer
+}
+
+// NewBatcher returns a Batcher delivering to n, coalescing for window.
+func NewBatcher(n Notifier, window time.Duration) *Batcher {
+	return &Batcher{Notifier: n, Window: window}
+}
+
+// Notify enqueues items for the next flush. When Window is zero or MaxBatch
+// is reached, Notify flushes right away and returns that flush's delivery
+// error. Otherwise it returns nil, and the items go out on the caller's next
+// Flush call (which returns any delivery error) or when Notify's internal
+// timer fires (which discards any delivery error).
+func (b *Batcher) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	b.mu.Lock()
+	b.pending = append(b.pending, items...)
+	flush := b.Window <= 0 || (b.MaxBatch > 0 && len(b.pending) >= b.MaxBatch)
+	if !flush && b.timer == nil {
+		b.timer = time.AfterFunc(b.Window, func() { b.Flush(context.Background()) })
+	}
+	b.mu.Unlock()
+
+	if flush {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush delivers and clears whatever has accumulated since the last flush.
+func (b *Batcher) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	items := b.pending
+	b.pending = nil
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.mu.Unlock()
+
+	if len(items) == 0 {
+		return nil
+	}
+	return b.Notifier.Notify(ctx, items)
+}
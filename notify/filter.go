@@ -0,0 +1,247 @@
+package notify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Filter is a compiled filter expression, e.g. `severity>=HIGH &&
+// vendor=="cisco"`. Supported fields are severity (compared by rank, using
+// ==, !=, <, <=, >, >=) and vendor (compared by exact, case-insensitive
+// match against any vendor on the CVE, using == or !=). Expressions combine
+// with && and ||, && binding tighter; there is no parenthesization.
+type Filter struct {
+	root filterExpr
+}
+
+// ParseFilter compiles expr into a Filter.
+func ParseFilter(expr string) (*Filter, error) {
+	toks, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{toks: toks}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.toks) {
+		return nil, fmt.Errorf("notify: unexpected token %q in filter", p.toks[p.pos])
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match reports whether item satisfies the filter.
+func (f *Filter) Match(item nvd.CVEItem) bool {
+	return f.root.eval(item)
+}
+
+type filterExpr interface {
+	eval(item nvd.CVEItem) bool
+}
+
+type andExpr struct{ left, right filterExpr }
+
+func (e andExpr) eval(item nvd.CVEItem) bool { return e.left.eval(item) && e.right.eval(item) }
+
+type orExpr struct{ left, right filterExpr }
+
+func (e orExpr) eval(item nvd.CVEItem) bool { return e.left.eval(item) || e.right.eval(item) }
+
+type severityExpr struct {
+	op  string
+	rhs nvd.Severity
+}
+
+var severityRank = map[nvd.Severity]int{
+	nvd.SeverityNone:     0,
+	nvd.SeverityLow:      1,
+	nvd.SeverityMedium:   2,
+	nvd.SeverityHigh:     3,
+	nvd.SeverityCritical: 4,
+}
+
+func (e severityExpr) eval(item nvd.CVEItem) bool {
+	sev, ok := item.Severity()
+	if !ok {
+		sev = nvd.SeverityNone
+	}
+	return compareOp(e.op, severityRank[sev], severityRank[e.rhs])
+}
+
+type vendorExpr struct {
+	op  string
+	rhs string
+}
+
+func (e vendorExpr) eval(item nvd.CVEItem) bool {
+	var match bool
+	for _, vd := range item.CVE.Affects.Vendor.VendorData {
+		if strings.EqualFold(vd.VendorName, e.rhs) {
+			match = true
+			break
+		}
+	}
+	switch e.op {
+	case "==":
+		return match
+	case "!=":
+		return !match
+	default:
+		return false
+	}
+}
+
+func compareOp(op string, lhs, rhs int) bool {
+	switch op {
+	case "==":
+		return lhs == rhs
+	case "!=":
+		return lhs != rhs
+	case ">=":
+		return lhs >= rhs
+	case "<=":
+		return lhs <= rhs
+	case ">":
+		return lhs > rhs
+	case "<":
+		return lhs < rhs
+	default:
+		return false
+	}
+}
+
+// --- tokenizer ---
+
+func tokenizeFilter(expr string) ([]string, error) {
+	var toks []string
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.HasPrefix(expr[i:], "&&"):
+			toks = append(toks, "&&")
+			i += 2
+		case strings.HasPrefix(expr[i:], "||"):
+			toks = append(toks, "||")
+			i += 2
+		case strings.HasPrefix(expr[i:], "=="), strings.HasPrefix(expr[i:], "!="),
+			strings.HasPrefix(expr[i:], ">="), strings.HasPrefix(expr[i:], "<="):
+			toks = append(toks, expr[i:i+2])
+			i += 2
+		case c == '>' || c == '<':
+			toks = append(toks, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(expr) && expr[j] != '"' {
+				j++
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("notify: unterminated string in filter: %q", expr)
+			}
+			val, err := strconv.Unquote(expr[i : j+1])
+			if err != nil {
+				return nil, err
+			}
+			toks = append(toks, `"`+val+`"`)
+			i = j + 1
+		default:
+			j := i
+			for j < len(expr) && !strings.ContainsRune(" \t&|=!><\"", rune(expr[j])) {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("notify: unexpected character %q in filter", expr[i])
+			}
+			toks = append(toks, expr[i:j])
+			i = j
+		}
+	}
+	return toks, nil
+}
+
+// --- parser ---
+
+type filterParser struct {
+	toks []string
+	pos  int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos]
+}
+
+func (p *filterParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *filterParser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterExpr, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseComparison() (filterExpr, error) {
+	field := p.next()
+	op := p.next()
+	switch op {
+	case "==", "!=", ">=", "<=", ">", "<":
+	default:
+		return nil, fmt.Errorf("notify: expected comparison operator, got %q", op)
+	}
+	rhs := p.next()
+	rhs = strings.Trim(rhs, `"`)
+
+	switch strings.ToLower(field) {
+	case "severity":
+		sev := nvd.Severity(strings.ToUpper(rhs))
+		if _, ok := severityRank[sev]; !ok {
+			return nil, fmt.Errorf("notify: unknown severity %q", rhs)
+		}
+		return severityExpr{op: op, rhs: sev}, nil
+	case "vendor":
+		if op != "==" && op != "!=" {
+			return nil, fmt.Errorf("notify: vendor only supports == and !=, got %q", op)
+		}
+		return vendorExpr{op: op, rhs: rhs}, nil
+	default:
+		return nil, fmt.Errorf("notify: unknown filter field %q", field)
+	}
+}
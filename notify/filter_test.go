@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+func itemWithSeverity(sev nvd.Severity) nvd.CVEItem {
+	return nvd.CVEItem{
+		Impact: nvd.Impact{
+			BaseMetricV3: nvd.BaseMetricV3{
+				CvssV3: nvd.CVSSV3{BaseSeverity: sev},
+			},
+		},
+	}
+}
+
+func itemWithVendor(vendor string) nvd.CVEItem {
+	var item nvd.CVEItem
+	item.CVE.Affects.Vendor.VendorData = []nvd.VendorData{{VendorName: vendor}}
+	return item
+}
+
+func TestParseFilterRejectsUnknownSeverity(t *testing.T) {
+	_, err := ParseFilter("severity>=CRITCAL")
+	if err == nil {
+		t.Fatal("ParseFilter(\"severity>=CRITCAL\") = nil error, want error for unknown severity")
+	}
+}
+
+func TestFilterSeverityComparison(t *testing.T) {
+	f, err := ParseFilter("severity>=HIGH")
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+	if f.Match(itemWithSeverity(nvd.SeverityLow)) {
+		t.Error("LOW severity matched severity>=HIGH")
+	}
+	if !f.Match(itemWithSeverity(nvd.SeverityCritical)) {
+		t.Error("CRITICAL severity didn't match severity>=HIGH")
+	}
+}
+
+func TestFilterVendorComparison(t *testing.T) {
+	f, err := ParseFilter(`vendor=="cisco"`)
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+	if !f.Match(itemWithVendor("Cisco")) {
+		t.Error("vendor==\"cisco\" didn't match case-insensitively")
+	}
+	if f.Match(itemWithVendor("juniper")) {
+		t.Error("vendor==\"cisco\" matched an unrelated vendor")
+	}
+}
+
+func TestFilterAndOr(t *testing.T) {
+	f, err := ParseFilter(`severity>=HIGH && vendor=="cisco"`)
+	if err != nil {
+		t.Fatalf("ParseFilter: %v", err)
+	}
+	item := itemWithSeverity(nvd.SeverityHigh)
+	item.CVE.Affects.Vendor.VendorData = []nvd.VendorData{{VendorName: "cisco"}}
+	if !f.Match(item) {
+		t.Error("expected match on severity>=HIGH && vendor==\"cisco\"")
+	}
+
+	item.CVE.Affects.Vendor.VendorData = []nvd.VendorData{{VendorName: "juniper"}}
+	if f.Match(item) {
+		t.Error("expected no match once vendor no longer matches")
+	}
+}
@@ -0,0 +1,84 @@
+// Package notify delivers CVE notifications to external systems (chat,
+// email, the fediverse) through a common interface, with template
+// rendering, filtering, retries, and batching layered on top.
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Notifier delivers a batch of CVEs somewhere.
+type Notifier interface {
+	Notify(ctx context.Context, items []nvd.CVEItem) error
+}
+
+// Filtered wraps a Notifier so only items matching f are delivered. If
+// filtering leaves nothing to send, the underlying Notifier is not called.
+func Filtered(n Notifier, f *Filter) Notifier {
+	return &filteredNotifier{n: n, f: f}
+}
+
+type filteredNotifier struct {
+	n Notifier
+	f *Filter
+}
+
+func (fn *filteredNotifier) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	var kept []nvd.CVEItem
+	for _, item := range items {
+		if fn.f.Match(item) {
+			kept = append(kept, item)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return fn.n.Notify(ctx, kept)
+}
+
+// Retrying wraps a Notifier, retrying a failed Notify call with backoff.
+type Retrying struct {
+	Notifier
+
+	// MaxAttempts is the total number of calls to attempt, including the
+	// first. Defaults to 3 if zero.
+	MaxAttempts int
+	// Backoff returns the delay before the given attempt (1-indexed,
+	// counting the attempt about to be retried). Defaults to an
+	// exponential 1s/2s/4s/... backoff if nil.
+	Backoff func(attempt int) time.Duration
+}
+
+func (r *Retrying) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	backoff := r.Backoff
+	if backoff == nil {
+		backoff = exponentialBackoff
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = r.Notifier.Notify(ctx, items); err == nil {
+			return nil
+		}
+		if attempt == maxAttempts {
+			break
+		}
+		select {
+		case <-time.After(backoff(attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+func exponentialBackoff(attempt int) time.Duration {
+	return time.Duration(1<<uint(attempt-1)) * time.Second
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Slack delivers CVEs to a Slack incoming webhook as Block Kit messages.
+type Slack struct {
+	WebhookURL string
+	HTTPClient *http.Client
+}
+
+// NewSlack returns a Slack notifier posting to webhookURL.
+func NewSlack(webhookURL string) *Slack {
+	return &Slack{WebhookURL: webhookURL}
+}
+
+type slackMessage struct {
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type string     `json:"type"`
+	Text *slackText `json:"text,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// slackMaxBlocksPerMessage is Slack's Block Kit limit on blocks in a single
+// message; a webhook post with more than this is rejected outright, with no
+// partial delivery.
+const slackMaxBlocksPerMessage = 50
+
+func (s *Slack) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	var blocks []slackBlock
+	for _, item := range items {
+		d := newTemplateData(item)
+		blocks = append(blocks, slackBlock{
+			Type: "section",
+			Text: &slackText{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*[%s] %s*\n%s", d.Severity, d.ID, d.Description),
+			},
+		})
+	}
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for len(blocks) > 0 {
+		n := slackMaxBlocksPerMessage
+		if n > len(blocks) {
+			n = len(blocks)
+		}
+		body, err := json.Marshal(slackMessage{Blocks: blocks[:n]})
+		if err != nil {
+			return err
+		}
+		if err := postJSON(ctx, client, s.WebhookURL, body); err != nil {
+			return err
+		}
+		blocks = blocks[n:]
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+func TestSlackNotifyChunksBlocksUnderLimit(t *testing.T) {
+	var posts []slackMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg slackMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Fatalf("decode post body: %v", err)
+		}
+		posts = append(posts, msg)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	items := make([]nvd.CVEItem, 120)
+	for i := range items {
+		items[i].CVE.CVEDataMeta = nvd.DataMeta{ID: "CVE-2024-0000"}
+	}
+
+	s := NewSlack(srv.URL)
+	if err := s.Notify(context.Background(), items); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	if len(posts) != 3 {
+		t.Fatalf("got %d posts, want 3 (120 blocks / 50 per message)", len(posts))
+	}
+	for i, msg := range posts[:2] {
+		if len(msg.Blocks) != slackMaxBlocksPerMessage {
+			t.Errorf("post %d has %d blocks, want %d", i, len(msg.Blocks), slackMaxBlocksPerMessage)
+		}
+	}
+	if len(posts[2].Blocks) != 20 {
+		t.Errorf("final post has %d blocks, want 20", len(posts[2].Blocks))
+	}
+}
@@ -0,0 +1,61 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// SMTP delivers CVEs by email, one message per batch passed to Notify.
+type SMTP struct {
+	Addr     string // host:port
+	Auth     smtp.Auth
+	From     string
+	To       []string
+	Subject  string    // defaults to "NVD notifications" if empty
+	Template *Template // defaults to DefaultTemplateText if nil
+}
+
+// NewSMTP returns an SMTP notifier. auth may be nil for an unauthenticated
+// relay.
+func NewSMTP(addr string, auth smtp.Auth, from string, to []string) *SMTP {
+	return &SMTP{Addr: addr, Auth: auth, From: from, To: to}
+}
+
+func (s *SMTP) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	tmpl := s.Template
+	if tmpl == nil {
+		var err error
+		tmpl, err = NewTemplate(DefaultTemplateText)
+		if err != nil {
+			return err
+		}
+	}
+
+	var body strings.Builder
+	for _, item := range items {
+		rendered, err := tmpl.Render(item)
+		if err != nil {
+			return err
+		}
+		body.WriteString(rendered)
+		body.WriteString("\n\n")
+	}
+
+	subject := s.Subject
+	if subject == "" {
+		subject = "NVD notifications"
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s",
+		s.From, strings.Join(s.To, ", "), subject, body.String())
+
+	return smtp.SendMail(s.Addr, s.Auth, s.From, s.To, []byte(msg))
+}
@@ -0,0 +1,121 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Mastodon posts each CVE as a status (toot) via the Mastodon REST API.
+type Mastodon struct {
+	InstanceURL string // e.g. "https://mastodon.social"
+	AccessToken string
+	HTTPClient  *http.Client
+}
+
+// NewMastodon returns a Mastodon notifier posting statuses to instanceURL.
+func NewMastodon(instanceURL, accessToken string) *Mastodon {
+	return &Mastodon{InstanceURL: instanceURL, AccessToken: accessToken}
+}
+
+func (m *Mastodon) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	client := m.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, item := range items {
+		d := newTemplateData(item)
+		status := strings.TrimSpace(d.Severity + " " + d.ID + ": " + d.Description)
+
+		form := url.Values{"status": {status}}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+			strings.TrimRight(m.InstanceURL, "/")+"/api/v1/statuses", strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Authorization", "Bearer "+m.AccessToken)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("notify: POST %s: %s", m.InstanceURL, resp.Status)
+		}
+	}
+	return nil
+}
+
+// Matrix posts each CVE as a message into a room via the Matrix
+// client-server API.
+type Matrix struct {
+	HomeserverURL string // e.g. "https://matrix.org"
+	AccessToken   string
+	RoomID        string // e.g. "!abc123:matrix.org"
+	HTTPClient    *http.Client
+}
+
+// NewMatrix returns a Matrix notifier posting into roomID.
+func NewMatrix(homeserverURL, accessToken, roomID string) *Matrix {
+	return &Matrix{HomeserverURL: homeserverURL, AccessToken: accessToken, RoomID: roomID}
+}
+
+type matrixMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+func (m *Matrix) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	client := m.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, item := range items {
+		d := newTemplateData(item)
+		body, err := json.Marshal(matrixMessage{
+			MsgType: "m.text",
+			Body:    strings.TrimSpace("[" + d.Severity + "] " + d.ID + ": " + d.Description),
+		})
+		if err != nil {
+			return err
+		}
+
+		// The transaction ID is the wall-clock time in nanoseconds rather
+		// than a counter: the Matrix API deduplicates sends by (access
+		// token, txn ID), and a counter reset by a process restart would
+		// collide with an earlier run's IDs within the homeserver's dedup
+		// window.
+		txnID := strconv.FormatInt(time.Now().UnixNano(), 10)
+		endpoint := strings.TrimRight(m.HomeserverURL, "/") +
+			"/_matrix/client/v3/rooms/" + url.PathEscape(m.RoomID) +
+			"/send/m.room.message/" + url.PathEscape(txnID)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, strings.NewReader(string(body)))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+m.AccessToken)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			return fmt.Errorf("notify: PUT %s: %s", endpoint, resp.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Teams delivers CVEs to a Microsoft Teams incoming webhook as
+// MessageCards, one per CVE.
+type Teams struct {
+	WebhookURL string
+	HTTPClient *http.Client
+}
+
+// NewTeams returns a Teams notifier posting to webhookURL.
+func NewTeams(webhookURL string) *Teams {
+	return &Teams{WebhookURL: webhookURL}
+}
+
+type teamsCard struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Title      string `json:"title"`
+	Text       string `json:"text"`
+}
+
+var teamsThemeColor = map[string]string{
+	"CRITICAL": "A80000",
+	"HIGH":     "D83B01",
+	"MEDIUM":   "FFB900",
+	"LOW":      "498205",
+}
+
+func (t *Teams) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	client := t.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	for _, item := range items {
+		d := newTemplateData(item)
+		card := teamsCard{
+			Type:       "MessageCard",
+			Context:    "http://schema.org/extensions",
+			Summary:    d.ID,
+			ThemeColor: teamsThemeColor[d.Severity],
+			Title:      fmt.Sprintf("[%s] %s", d.Severity, d.ID),
+			Text:       d.Description,
+		}
+		body, err := json.Marshal(card)
+		if err != nil {
+			return err
+		}
+		if err := postJSON(ctx, client, t.WebhookURL, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
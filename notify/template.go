@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// DefaultTemplateText is used by adapters that don't have their own
+// format-specific default (e.g. the SMTP body).
+const DefaultTemplateText = `[{{.Severity}}] {{.ID}}: {{.Description}}
+{{range .References}}{{.}}
+{{end}}`
+
+// templateData is the flattened, template-friendly view of a CVEItem that
+// {{.Field}} expressions in a Template render against.
+type templateData struct {
+	ID          string
+	Description string
+	Severity    string
+	Published   string
+	Modified    string
+	References  []string
+	Vendors     []string
+}
+
+func newTemplateData(item nvd.CVEItem) templateData {
+	desc := ""
+	for _, d := range item.CVE.Description.DescriptionData {
+		if d.Lang == "en" || desc == "" {
+			desc = d.Value
+		}
+	}
+
+	severity, _ := item.Severity()
+
+	refs := make([]string, 0, len(item.CVE.References.ReferenceData))
+	for _, r := range item.CVE.References.ReferenceData {
+		refs = append(refs, r.URL)
+	}
+
+	var vendors []string
+	for _, vd := range item.CVE.Affects.Vendor.VendorData {
+		vendors = append(vendors, vd.VendorName)
+	}
+
+	return templateData{
+		ID:          item.CVE.CVEDataMeta.ID,
+		Description: desc,
+		Severity:    string(severity),
+		Published:   item.PublishedDate,
+		Modified:    item.LastModifiedDate,
+		References:  refs,
+		Vendors:     vendors,
+	}
+}
+
+// Template renders a CVEItem using Go's text/template syntax against the
+// fields ID, Description, Severity, Published, Modified, References, and
+// Vendors.
+type Template struct {
+	tmpl *template.Template
+}
+
+// NewTemplate parses text as a message template.
+func NewTemplate(text string) (*Template, error) {
+	tmpl, err := template.New("notify").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{tmpl: tmpl}, nil
+}
+
+// Render executes the template against item.
+func (t *Template) Render(item nvd.CVEItem) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, newTemplateData(item)); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// Webhook POSTs each CVE as a JSON object to a generic endpoint. It's the
+// adapter to reach anything that doesn't have a dedicated one.
+type Webhook struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// NewWebhook returns a Webhook posting to url.
+func NewWebhook(url string) *Webhook {
+	return &Webhook{URL: url}
+}
+
+type webhookPayload struct {
+	ID          string   `json:"id"`
+	Severity    string   `json:"severity,omitempty"`
+	Description string   `json:"description"`
+	Published   string   `json:"published"`
+	Modified    string   `json:"modified"`
+	References  []string `json:"references,omitempty"`
+}
+
+func (w *Webhook) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	for _, item := range items {
+		if err := w.post(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Webhook) post(ctx context.Context, item nvd.CVEItem) error {
+	data := newTemplateData(item)
+	body, err := json.Marshal(webhookPayload{
+		ID:          data.ID,
+		Severity:    data.Severity,
+		Description: data.Description,
+		Published:   data.Published,
+		Modified:    data.Modified,
+		References:  data.References,
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, w.httpClient(), w.URL, body)
+}
+
+func (w *Webhook) httpClient() *http.Client {
+	if w.HTTPClient != nil {
+		return w.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// postJSON is shared by the webhook-flavored adapters (webhook, Slack,
+// Teams): POST body to url and treat any non-2xx status as an error.
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("notify: POST %s: %s", url, resp.Status)
+	}
+	return nil
+}
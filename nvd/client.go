@@ -0,0 +1,292 @@
+package nvd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultBaseURL is the NVD 2.0 REST API endpoint.
+const DefaultBaseURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+// defaultResultsPerPage is the page size used by Sync. NVD allows up to 2000.
+const defaultResultsPerPage = 2000
+
+// Client talks to the NVD 2.0 REST API. The zero value is usable and talks
+// to DefaultBaseURL on the public tier; NewClient sets APIKey.
+type Client struct {
+	// BaseURL overrides DefaultBaseURL, mainly for tests.
+	BaseURL string
+	// APIKey, if set, is sent as the apiKey header and raises the allowed
+	// request rate per NVD's published guidance.
+	APIKey string
+	// HTTPClient is used to make requests. http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	// mu guards lastRequest and lastErr: Sync's background goroutine
+	// writes them while throttle/Err may be called concurrently, including
+	// from a second Sync call made before the first's channel is drained.
+	mu          sync.Mutex
+	lastRequest time.Time
+	lastErr     error
+}
+
+// NewClient returns a Client. apiKey may be empty to use the public,
+// more heavily rate-limited tier.
+func NewClient(apiKey string) *Client {
+	return &Client{APIKey: apiKey}
+}
+
+// maxLastModRange is the widest span NVD's 2.0 API allows between
+// lastModStartDate and lastModEndDate in a single request; Sync splits
+// wider ranges into consecutive windows of at most this length.
+const maxLastModRange = 120 * 24 * time.Hour
+
+// Sync streams every CVE modified at or after since, paging through the NVD
+// 2.0 API and respecting its rate limits. The returned channel is closed
+// after the final page is delivered, a page fetch fails, or ctx is
+// cancelled; either way, callers should keep draining it until it closes,
+// then check Err to tell a completed sync from one truncated by a failure.
+// A Client serializes its own internal bookkeeping, but Sync itself is not
+// meant to be called again on the same Client until the previous call's
+// channel has closed — two syncs running concurrently would each throttle
+// against, and report errors from, the same shared rate-limit and Err state.
+func (c *Client) Sync(ctx context.Context, since time.Time) (<-chan CVEItem, error) {
+	out := make(chan CVEItem)
+	c.setErr(nil)
+
+	go func() {
+		defer close(out)
+
+		for _, w := range lastModWindows(since, time.Now()) {
+			startIndex := 0
+			for {
+				resp, err := c.fetchPage(ctx, w.start, w.end, startIndex)
+				if err != nil {
+					c.setErr(err)
+					return
+				}
+				for _, v := range resp.Vulnerabilities {
+					item := v.CVE.toCVEItem()
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						c.setErr(ctx.Err())
+						return
+					}
+				}
+
+				startIndex += len(resp.Vulnerabilities)
+				if startIndex >= resp.TotalResults || len(resp.Vulnerabilities) == 0 {
+					break
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+type lastModWindow struct {
+	start, end time.Time
+}
+
+// lastModWindows splits [since, until] into consecutive windows of at most
+// maxLastModRange, the widest span NVD's 2.0 API accepts between
+// lastModStartDate and lastModEndDate. It returns a single zero-value window
+// when since is zero, since that case omits both date params entirely.
+func lastModWindows(since, until time.Time) []lastModWindow {
+	if since.IsZero() {
+		return []lastModWindow{{}}
+	}
+
+	var windows []lastModWindow
+	for start := since; start.Before(until); start = start.Add(maxLastModRange) {
+		end := start.Add(maxLastModRange)
+		if end.After(until) {
+			end = until
+		}
+		windows = append(windows, lastModWindow{start: start, end: end})
+	}
+	return windows
+}
+
+// Err returns the error that ended the most recent Sync, if the channel it
+// returned closed early rather than running to completion. It is only
+// meaningful after that channel has been fully drained.
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastErr
+}
+
+func (c *Client) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastErr = err
+}
+
+func (c *Client) fetchPage(ctx context.Context, since, until time.Time, startIndex int) (*restResponse, error) {
+	c.throttle()
+
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+
+	q := url.Values{}
+	if !since.IsZero() {
+		q.Set("lastModStartDate", since.UTC().Format(time.RFC3339))
+		q.Set("lastModEndDate", until.UTC().Format(time.RFC3339))
+	}
+	q.Set("resultsPerPage", strconv.Itoa(defaultResultsPerPage))
+	q.Set("startIndex", strconv.Itoa(startIndex))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.APIKey != "" {
+		req.Header.Set("apiKey", c.APIKey)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nvd: GET %s: %s", base, resp.Status)
+	}
+
+	var out restResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// throttle sleeps as needed to space requests at least 5s apart without an
+// API key and 0.6s apart with one. NVD publishes its limits as 5 requests
+// per rolling 30s window without a key and 50 per 30s with one; 0.6s is the
+// keyed limit's average spacing, while 5s is shorter than the 6s average
+// spacing of the keyless limit.
+func (c *Client) throttle() {
+	interval := 5 * time.Second
+	if c.APIKey != "" {
+		interval = 600 * time.Millisecond
+	}
+
+	c.mu.Lock()
+	wait := interval - time.Since(c.lastRequest)
+	c.mu.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+
+	c.mu.Lock()
+	c.lastRequest = time.Now()
+	c.mu.Unlock()
+}
+
+// restResponse is the envelope returned by the NVD 2.0 API.
+type restResponse struct {
+	ResultsPerPage  int                 `json:"resultsPerPage"`
+	StartIndex      int                 `json:"startIndex"`
+	TotalResults    int                 `json:"totalResults"`
+	Vulnerabilities []restVulnerability `json:"vulnerabilities"`
+}
+
+type restVulnerability struct {
+	CVE restCVE `json:"cve"`
+}
+
+// restCVE is the 2.0 API's CVE representation. It is intentionally a
+// separate type from CVE (the 1.0 feed schema): field names and nesting
+// differ between the two API versions.
+type restCVE struct {
+	ID               string          `json:"id"`
+	SourceIdentifier string          `json:"sourceIdentifier"`
+	Published        string          `json:"published"`
+	LastModified     string          `json:"lastModified"`
+	Descriptions     []Description   `json:"descriptions"`
+	Metrics          restMetrics     `json:"metrics"`
+	References       []restReference `json:"references"`
+}
+
+type restMetrics struct {
+	CvssMetricV31 []restCvssMetricV3 `json:"cvssMetricV31"`
+	CvssMetricV30 []restCvssMetricV3 `json:"cvssMetricV30"`
+	CvssMetricV2  []restCvssMetricV2 `json:"cvssMetricV2"`
+}
+
+type restCvssMetricV3 struct {
+	CvssData            CVSSV3  `json:"cvssData"`
+	ExploitabilityScore float64 `json:"exploitabilityScore"`
+	ImpactScore         float64 `json:"impactScore"`
+}
+
+type restCvssMetricV2 struct {
+	CvssData CVSSV2 `json:"cvssData"`
+}
+
+type restReference struct {
+	URL    string   `json:"url"`
+	Source string   `json:"source"`
+	Tags   []string `json:"tags"`
+}
+
+// toCVEItem translates a 2.0 API CVE record into the CVEItem shape the rest
+// of this package (and its callers) already work with, so Sync can be
+// dropped in wherever Recent/Modified are used today.
+func (r restCVE) toCVEItem() CVEItem {
+	item := CVEItem{
+		PublishedDate:    r.Published,
+		LastModifiedDate: r.LastModified,
+	}
+	item.CVE.CVEDataMeta = DataMeta{ID: r.ID, ASSIGNER: r.SourceIdentifier}
+	item.CVE.Description = CVEDescription{DescriptionData: r.Descriptions}
+
+	refs := make([]ReferenceData, 0, len(r.References))
+	for _, ref := range r.References {
+		refs = append(refs, ReferenceData{
+			URL:       ref.URL,
+			Refsource: ref.Source,
+			Tags:      ref.Tags,
+		})
+	}
+	item.CVE.References = References{ReferenceData: refs}
+
+	if len(r.Metrics.CvssMetricV31) > 0 {
+		m := r.Metrics.CvssMetricV31[0]
+		item.Impact.BaseMetricV3 = BaseMetricV3{
+			CvssV3:              m.CvssData,
+			ExploitabilityScore: m.ExploitabilityScore,
+			ImpactScore:         m.ImpactScore,
+		}
+	} else if len(r.Metrics.CvssMetricV30) > 0 {
+		m := r.Metrics.CvssMetricV30[0]
+		item.Impact.BaseMetricV3 = BaseMetricV3{
+			CvssV3:              m.CvssData,
+			ExploitabilityScore: m.ExploitabilityScore,
+			ImpactScore:         m.ImpactScore,
+		}
+	}
+	if len(r.Metrics.CvssMetricV2) > 0 {
+		item.Impact.BaseMetricV2 = BaseMetricV2{CvssV2: r.Metrics.CvssMetricV2[0].CvssData}
+	}
+
+	return item
+}
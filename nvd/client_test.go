@@ -0,0 +1,50 @@
+package nvd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLastModWindowsSplitsWideRanges(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(300 * 24 * time.Hour)
+
+	windows := lastModWindows(since, until)
+	if len(windows) != 3 {
+		t.Fatalf("got %d windows, want 3 (300 days / 120-day windows)", len(windows))
+	}
+	if !windows[0].start.Equal(since) {
+		t.Errorf("first window start = %v, want %v", windows[0].start, since)
+	}
+	if !windows[len(windows)-1].end.Equal(until) {
+		t.Errorf("last window end = %v, want %v", windows[len(windows)-1].end, until)
+	}
+	for i := 0; i < len(windows)-1; i++ {
+		if windows[i].end.Sub(windows[i].start) > maxLastModRange {
+			t.Errorf("window %d spans %v, want at most %v", i, windows[i].end.Sub(windows[i].start), maxLastModRange)
+		}
+		if !windows[i].end.Equal(windows[i+1].start) {
+			t.Errorf("window %d end %v != window %d start %v", i, windows[i].end, i+1, windows[i+1].start)
+		}
+	}
+}
+
+func TestLastModWindowsNarrowRangeIsOneWindow(t *testing.T) {
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(10 * 24 * time.Hour)
+
+	windows := lastModWindows(since, until)
+	if len(windows) != 1 {
+		t.Fatalf("got %d windows, want 1", len(windows))
+	}
+	if !windows[0].start.Equal(since) || !windows[0].end.Equal(until) {
+		t.Errorf("window = %+v, want {%v %v}", windows[0], since, until)
+	}
+}
+
+func TestLastModWindowsZeroSinceIsUnbounded(t *testing.T) {
+	windows := lastModWindows(time.Time{}, time.Now())
+	if len(windows) != 1 || !windows[0].start.IsZero() || !windows[0].end.IsZero() {
+		t.Fatalf("got %+v, want a single zero-value window", windows)
+	}
+}
@@ -0,0 +1,97 @@
+package nvd
+
+import (
+	"github.com/jadkorr/nvdnotifier/cpe"
+)
+
+// Matches reports whether any of the given inventory CPEs satisfy this
+// configuration tree. Each node's operator (AND/OR) combines its direct
+// cpe_match entries and child nodes; Negate inverts the node's result, per
+// the NVD configuration schema.
+func (c Configurations) Matches(cpes []cpe.Name) bool {
+	for _, n := range c.Nodes {
+		if n.matches(cpes) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n Node) matches(cpes []cpe.Name) bool {
+	result := n.evaluate(cpes)
+	if n.Negate {
+		return !result
+	}
+	return result
+}
+
+func (n Node) evaluate(cpes []cpe.Name) bool {
+	and := n.Operator == "AND"
+
+	if and {
+		for _, m := range n.CpeMatch {
+			if !m.matches(cpes) {
+				return false
+			}
+		}
+		for _, child := range n.Children {
+			if !child.matches(cpes) {
+				return false
+			}
+		}
+		return len(n.CpeMatch) > 0 || len(n.Children) > 0
+	}
+
+	for _, m := range n.CpeMatch {
+		if m.matches(cpes) {
+			return true
+		}
+	}
+	for _, child := range n.Children {
+		if child.matches(cpes) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches reports whether any inventory CPE satisfies this match entry:
+// the CPE 2.3 component comparison plus any version range bounds.
+func (m CPEMatch) matches(cpes []cpe.Name) bool {
+	if !m.Vulnerable {
+		return false
+	}
+	pattern, err := cpe.Parse(m.Cpe23URI)
+	if err != nil {
+		return false
+	}
+	for _, candidate := range cpes {
+		if !pattern.Matches(candidate) {
+			continue
+		}
+		if m.inVersionRange(candidate.Version) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m CPEMatch) inVersionRange(version string) bool {
+	if m.VersionStartIncluding == "" && m.VersionStartExcluding == "" &&
+		m.VersionEndIncluding == "" && m.VersionEndExcluding == "" {
+		return true
+	}
+	if v := m.VersionStartIncluding; v != "" && cpe.LessThan(version, v) {
+		return false
+	}
+	if v := m.VersionStartExcluding; v != "" && !cpe.LessThan(v, version) {
+		return false
+	}
+	if v := m.VersionEndIncluding; v != "" && cpe.LessThan(v, version) {
+		return false
+	}
+	if v := m.VersionEndExcluding; v != "" && !cpe.LessThan(version, v) {
+		return false
+	}
+	return true
+}
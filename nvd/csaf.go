@@ -0,0 +1,107 @@
+package nvd
+
+import (
+	"fmt"
+
+	"github.com/jadkorr/nvdnotifier/cpe"
+	"github.com/jadkorr/nvdnotifier/csaf"
+)
+
+// ToCSAF converts the feed to a CSAF 2.0 VEX document, for feeding
+// downstream scanners that consume CSAF rather than the legacy NVD 1.0
+// format.
+func (d Data) ToCSAF() csaf.Document {
+	doc := csaf.Document{
+		Document: csaf.DocumentMeta{
+			Category:    "csaf_vex",
+			CSAFVersion: "2.0",
+			Title:       "nvdnotifier NVD export",
+			Publisher: csaf.Publisher{
+				Category:  "vendor",
+				Name:      "nvdnotifier",
+				Namespace: "https://github.com/jadkorr/nvdnotifier",
+			},
+			Tracking: csaf.Tracking{
+				ID:                 "nvdnotifier-" + d.CVEDataTimestamp,
+				Status:             "final",
+				Version:            "1",
+				InitialReleaseDate: d.CVEDataTimestamp,
+				CurrentReleaseDate: d.CVEDataTimestamp,
+				RevisionHistory: []csaf.RevisionEntry{{
+					Date:    d.CVEDataTimestamp,
+					Number:  "1",
+					Summary: "Initial export from nvdnotifier",
+				}},
+			},
+		},
+	}
+
+	productIDs := map[string]bool{}
+
+	for _, item := range d.CVEItems {
+		vuln := csaf.Vulnerability{CVE: item.CVE.CVEDataMeta.ID}
+
+		for _, desc := range item.CVE.Description.DescriptionData {
+			if desc.Lang == "en" {
+				vuln.Notes = append(vuln.Notes, csaf.Note{Category: "description", Text: desc.Value})
+				break
+			}
+		}
+
+		for _, ref := range item.CVE.References.ReferenceData {
+			vuln.References = append(vuln.References, csaf.Reference{
+				Category: "external",
+				Summary:  ref.Name,
+				URL:      ref.URL,
+			})
+		}
+
+		var productIDsForItem []string
+		var walk func(n Node)
+		walk = func(n Node) {
+			for _, m := range n.CpeMatch {
+				if !m.Vulnerable {
+					continue
+				}
+				name, err := cpe.Parse(m.Cpe23URI)
+				if err != nil {
+					continue
+				}
+				id := fmt.Sprintf("%s:%s", name.Vendor, name.Product)
+				if !productIDs[id] {
+					productIDs[id] = true
+					doc.ProductTree.FullProductNames = append(doc.ProductTree.FullProductNames, csaf.FullProductName{
+						ProductID: id,
+						Name:      fmt.Sprintf("%s %s", name.Vendor, name.Product),
+					})
+				}
+				productIDsForItem = append(productIDsForItem, id)
+			}
+			for _, child := range n.Children {
+				walk(child)
+			}
+		}
+		for _, n := range item.Configurations.Nodes {
+			walk(n)
+		}
+		if len(productIDsForItem) > 0 {
+			vuln.ProductStatus = &csaf.ProductStatus{KnownAffected: productIDsForItem}
+		}
+
+		if v3 := item.Impact.BaseMetricV3.CvssV3; v3.VectorString != "" && len(productIDsForItem) > 0 {
+			vuln.Scores = append(vuln.Scores, csaf.Score{
+				Products: productIDsForItem,
+				CVSSV3: &csaf.CVSSV3{
+					Version:      v3.Version,
+					VectorString: v3.VectorString,
+					BaseScore:    v3.BaseScore,
+					BaseSeverity: string(v3.BaseSeverity),
+				},
+			})
+		}
+
+		doc.Vulnerabilities = append(doc.Vulnerabilities, vuln)
+	}
+
+	return doc
+}
@@ -0,0 +1,38 @@
+package nvd
+
+import "testing"
+
+func TestToCSAFOmitsProductStatusWhenNoCPEsResolve(t *testing.T) {
+	data := Data{
+		CVEItems: []CVEItem{{
+			CVE: CVE{CVEDataMeta: DataMeta{ID: "CVE-2024-0001"}},
+		}},
+	}
+
+	vuln := data.ToCSAF().Vulnerabilities[0]
+	if vuln.ProductStatus != nil {
+		t.Errorf("ProductStatus = %+v, want nil when no CPEs resolve", vuln.ProductStatus)
+	}
+}
+
+func TestToCSAFSetsProductStatusWhenCPEsResolve(t *testing.T) {
+	data := Data{
+		CVEItems: []CVEItem{{
+			CVE: CVE{CVEDataMeta: DataMeta{ID: "CVE-2024-0002"}},
+			Configurations: Configurations{
+				Nodes: []Node{{
+					Operator: "OR",
+					CpeMatch: []CPEMatch{{
+						Vulnerable: true,
+						Cpe23URI:   "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*",
+					}},
+				}},
+			},
+		}},
+	}
+
+	vuln := data.ToCSAF().Vulnerabilities[0]
+	if vuln.ProductStatus == nil || len(vuln.ProductStatus.KnownAffected) != 1 {
+		t.Errorf("ProductStatus = %+v, want one known-affected product", vuln.ProductStatus)
+	}
+}
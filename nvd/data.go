@@ -69,6 +69,7 @@ func (cve CVEItem) Hash() (string, error) {
 
 type Impact struct {
 	BaseMetricV2 BaseMetricV2 `json:"baseMetricV2"`
+	BaseMetricV3 BaseMetricV3 `json:"baseMetricV3"`
 }
 
 type BaseMetricV2 struct {
@@ -94,6 +95,27 @@ type CVSSV2 struct {
 	BaseScore             float64 `json:"baseScore"`
 }
 
+type BaseMetricV3 struct {
+	CvssV3              CVSSV3  `json:"cvssV3"`
+	ExploitabilityScore float64 `json:"exploitabilityScore"`
+	ImpactScore         float64 `json:"impactScore"`
+}
+
+type CVSSV3 struct {
+	Version               string             `json:"version"`
+	VectorString          string             `json:"vectorString"`
+	AttackVector          AttackVector       `json:"attackVector"`
+	AttackComplexity      AttackComplexity   `json:"attackComplexity"`
+	PrivilegesRequired    PrivilegesRequired `json:"privilegesRequired"`
+	UserInteraction       UserInteraction    `json:"userInteraction"`
+	Scope                 Scope              `json:"scope"`
+	ConfidentialityImpact CIAImpact          `json:"confidentialityImpact"`
+	IntegrityImpact       CIAImpact          `json:"integrityImpact"`
+	AvailabilityImpact    CIAImpact          `json:"availabilityImpact"`
+	BaseScore             float64            `json:"baseScore"`
+	BaseSeverity          Severity           `json:"baseSeverity"`
+}
+
 type CVE struct {
 	DataType    string         `json:"data_type"`
 	DataFormat  string         `json:"data_format"`
@@ -176,11 +198,16 @@ type Configurations struct {
 
 type Node struct {
 	Operator string     `json:"operator"`
+	Negate   bool       `json:"negate"`
 	CpeMatch []CPEMatch `json:"cpe_match"`
+	Children []Node     `json:"children"`
 }
 
 type CPEMatch struct {
-	Vulnerable          bool   `json:"vulnerable"`
-	Cpe23URI            string `json:"cpe23Uri"`
-	VersionEndExcluding string `json:"versionEndExcluding,omitempty"`
+	Vulnerable            bool   `json:"vulnerable"`
+	Cpe23URI              string `json:"cpe23Uri"`
+	VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding string `json:"versionStartExcluding,omitempty"`
+	VersionEndIncluding   string `json:"versionEndIncluding,omitempty"`
+	VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
 }
@@ -0,0 +1,145 @@
+package nvd
+
+import (
+	"fmt"
+
+	"github.com/jadkorr/nvdnotifier/cpe"
+	"github.com/jadkorr/nvdnotifier/osv"
+)
+
+// ToOSV converts the CVE to the OSV schema, for feeding downstream tools
+// (govulncheck, trivy, grype, Go's vulndb) that consume OSV rather than
+// NVD's own format.
+func (cve CVEItem) ToOSV() osv.Entry {
+	e := osv.Entry{
+		SchemaVersion: "1.6.0",
+		ID:            cve.CVE.CVEDataMeta.ID,
+		Published:     cve.PublishedDate,
+		Modified:      cve.LastModifiedDate,
+	}
+
+	for _, d := range cve.CVE.Description.DescriptionData {
+		if d.Lang == "en" {
+			e.Details = d.Value
+			break
+		}
+	}
+
+	if v3 := cve.Impact.BaseMetricV3.CvssV3; v3.VectorString != "" {
+		e.Severity = append(e.Severity, osv.Severity{Type: "CVSS_V3", Score: v3.VectorString})
+	} else if v2 := cve.Impact.BaseMetricV2.CvssV2; v2.VectorString != "" {
+		e.Severity = append(e.Severity, osv.Severity{Type: "CVSS_V2", Score: v2.VectorString})
+	}
+
+	for _, ref := range cve.CVE.References.ReferenceData {
+		e.References = append(e.References, osv.Reference{Type: osvReferenceType(ref), URL: ref.URL})
+	}
+
+	e.Affected = cve.Configurations.toOSVAffected()
+
+	return e
+}
+
+func osvReferenceType(ref ReferenceData) string {
+	for _, tag := range ref.Tags {
+		if tag == "Patch" {
+			return "FIX"
+		}
+		if tag == "Vendor Advisory" {
+			return "ADVISORY"
+		}
+		if tag == "Exploit" {
+			return "EVIDENCE"
+		}
+	}
+	return "WEB"
+}
+
+// CWEs returns the CWE identifiers (e.g. "CWE-79") listed under
+// problemtype, skipping the "NVD-CWE-Other"/"NVD-CWE-noinfo" placeholders
+// NVD uses when a weakness hasn't been classified.
+func (cve CVEItem) CWEs() []string {
+	var out []string
+	for _, pt := range cve.CVE.Problemtype.ProblemtypeData {
+		for _, d := range pt.Description {
+			if d.Value == "" || d.Value == "NVD-CWE-Other" || d.Value == "NVD-CWE-noinfo" {
+				continue
+			}
+			out = append(out, d.Value)
+		}
+	}
+	return out
+}
+
+// toOSVAffected flattens the configuration tree's cpe_match entries into
+// OSV affected[] entries, one per distinct (vendor, product). Each
+// cpe_match entry's representable version bounds become one ECOSYSTEM range
+// on its product's entry, so a product can carry several ranges. An exact
+// version with no range would need a "fixed": nextVersion event, which
+// isn't knowable from NVD data, so unbounded matches add no range and
+// downstream consumers should treat them as "affects all versions NVD
+// listed this CPE against".
+func (c Configurations) toOSVAffected() []osv.Affected {
+	byProduct := map[string]*osv.Affected{}
+	var order []string
+
+	var walk func(n Node)
+	walk = func(n Node) {
+		for _, m := range n.CpeMatch {
+			if !m.Vulnerable {
+				continue
+			}
+			name, err := cpe.Parse(m.Cpe23URI)
+			if err != nil {
+				continue
+			}
+			key := name.Vendor + ":" + name.Product
+			a, ok := byProduct[key]
+			if !ok {
+				a = &osv.Affected{Package: osv.Package{
+					Ecosystem: "NVD",
+					Name:      fmt.Sprintf("%s:%s", name.Vendor, name.Product),
+				}}
+				byProduct[key] = a
+				order = append(order, key)
+			}
+
+			// OSV's "introduced" is an inclusive lower bound and "fixed" is
+			// an exclusive upper bound, so only the NVD bounds with matching
+			// semantics translate directly. VersionStartExcluding and
+			// VersionEndIncluding have no exact OSV equivalent (there's no
+			// portable "next version after X"); rather than emit a range
+			// that's off by one version, we drop just that bound and keep
+			// whichever other bound on the match is representable.
+			//
+			// The OSV schema also requires a range's first event to be
+			// "introduced", so when NVD only gives an upper bound ("all
+			// versions before X") we emit the Go vulndb/OSV-Scanner
+			// convention of an explicit "introduced":"0" first.
+			var events []osv.Event
+			if m.VersionStartIncluding != "" {
+				events = append(events, osv.Event{Introduced: m.VersionStartIncluding})
+			} else if m.VersionEndExcluding != "" {
+				events = append(events, osv.Event{Introduced: "0"})
+			}
+			if m.VersionEndExcluding != "" {
+				events = append(events, osv.Event{Fixed: m.VersionEndExcluding})
+			}
+			if len(events) > 0 {
+				a.Ranges = append(a.Ranges, osv.Range{Type: "ECOSYSTEM", Events: events})
+			}
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, n := range c.Nodes {
+		walk(n)
+	}
+
+	out := make([]osv.Affected, 0, len(order))
+	for _, key := range order {
+		out = append(out, *byProduct[key])
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package nvd
+
+import "testing"
+
+func matchWithBounds(endExcluding, startIncluding string) CPEMatch {
+	return CPEMatch{
+		Vulnerable:            true,
+		Cpe23URI:              "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*",
+		VersionStartIncluding: startIncluding,
+		VersionEndExcluding:   endExcluding,
+	}
+}
+
+func TestToOSVUpperBoundOnlyStartsWithIntroducedZero(t *testing.T) {
+	item := CVEItem{
+		CVE: CVE{CVEDataMeta: DataMeta{ID: "CVE-2021-44228"}},
+		Configurations: Configurations{
+			Nodes: []Node{{
+				Operator: "OR",
+				CpeMatch: []CPEMatch{matchWithBounds("2.5.0", "")},
+			}},
+		},
+	}
+
+	entry := item.ToOSV()
+	if len(entry.Affected) != 1 {
+		t.Fatalf("Affected = %d entries, want 1", len(entry.Affected))
+	}
+	ranges := entry.Affected[0].Ranges
+	if len(ranges) != 1 {
+		t.Fatalf("Ranges = %d entries, want 1", len(ranges))
+	}
+	events := ranges[0].Events
+	if len(events) != 2 {
+		t.Fatalf("Events = %+v, want 2 events", events)
+	}
+	if events[0].Introduced != "0" {
+		t.Errorf("Events[0] = %+v, want first event introduced=\"0\"", events[0])
+	}
+	if events[1].Fixed != "2.5.0" {
+		t.Errorf("Events[1] = %+v, want fixed=\"2.5.0\"", events[1])
+	}
+}
+
+func TestToOSVBothBoundsPresent(t *testing.T) {
+	item := CVEItem{
+		CVE: CVE{CVEDataMeta: DataMeta{ID: "CVE-2021-44228"}},
+		Configurations: Configurations{
+			Nodes: []Node{{
+				Operator: "OR",
+				CpeMatch: []CPEMatch{matchWithBounds("2.5.0", "2.0.0")},
+			}},
+		},
+	}
+
+	events := item.ToOSV().Affected[0].Ranges[0].Events
+	if len(events) != 2 || events[0].Introduced != "2.0.0" || events[1].Fixed != "2.5.0" {
+		t.Errorf("Events = %+v, want [{introduced:2.0.0} {fixed:2.5.0}]", events)
+	}
+}
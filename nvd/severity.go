@@ -0,0 +1,187 @@
+package nvd
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Severity is the CVSS qualitative severity rating. It is shared between
+// CVSS v2 and v3.1 so callers can filter/route without string comparisons.
+type Severity string
+
+const (
+	SeverityNone     Severity = "NONE"
+	SeverityLow      Severity = "LOW"
+	SeverityMedium   Severity = "MEDIUM"
+	SeverityHigh     Severity = "HIGH"
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// UnmarshalJSON rejects severity strings NVD hasn't defined so callers never
+// silently treat an unrecognized rating as a zero value.
+func (s *Severity) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch v := Severity(raw); v {
+	case SeverityNone, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical:
+		*s = v
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown severity %q", raw)
+	}
+}
+
+// AttackVector is the CVSS v3.1 AV metric.
+type AttackVector string
+
+const (
+	AVNetwork         AttackVector = "NETWORK"
+	AVAdjacentNetwork AttackVector = "ADJACENT_NETWORK"
+	AVLocal           AttackVector = "LOCAL"
+	AVPhysical        AttackVector = "PHYSICAL"
+)
+
+func (v *AttackVector) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch av := AttackVector(raw); av {
+	case AVNetwork, AVAdjacentNetwork, AVLocal, AVPhysical:
+		*v = av
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown attackVector %q", raw)
+	}
+}
+
+// AttackComplexity is the CVSS v3.1 AC metric.
+type AttackComplexity string
+
+const (
+	ACLow  AttackComplexity = "LOW"
+	ACHigh AttackComplexity = "HIGH"
+)
+
+func (c *AttackComplexity) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch ac := AttackComplexity(raw); ac {
+	case ACLow, ACHigh:
+		*c = ac
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown attackComplexity %q", raw)
+	}
+}
+
+// PrivilegesRequired is the CVSS v3.1 PR metric.
+type PrivilegesRequired string
+
+const (
+	PRNone PrivilegesRequired = "NONE"
+	PRLow  PrivilegesRequired = "LOW"
+	PRHigh PrivilegesRequired = "HIGH"
+)
+
+func (p *PrivilegesRequired) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch pr := PrivilegesRequired(raw); pr {
+	case PRNone, PRLow, PRHigh:
+		*p = pr
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown privilegesRequired %q", raw)
+	}
+}
+
+// UserInteraction is the CVSS v3.1 UI metric.
+type UserInteraction string
+
+const (
+	UINone     UserInteraction = "NONE"
+	UIRequired UserInteraction = "REQUIRED"
+)
+
+func (u *UserInteraction) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch ui := UserInteraction(raw); ui {
+	case UINone, UIRequired:
+		*u = ui
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown userInteraction %q", raw)
+	}
+}
+
+// Scope is the CVSS v3.1 S metric.
+type Scope string
+
+const (
+	SUnchanged Scope = "UNCHANGED"
+	SChanged   Scope = "CHANGED"
+)
+
+func (s *Scope) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch sc := Scope(raw); sc {
+	case SUnchanged, SChanged:
+		*s = sc
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown scope %q", raw)
+	}
+}
+
+// CIAImpact is the CVSS v3.1 C/I/A impact metric.
+type CIAImpact string
+
+const (
+	CIAImpactNone CIAImpact = "NONE"
+	CIAImpactLow  CIAImpact = "LOW"
+	CIAImpactHigh CIAImpact = "HIGH"
+)
+
+func (c *CIAImpact) UnmarshalJSON(b []byte) error {
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	switch impact := CIAImpact(raw); impact {
+	case CIAImpactNone, CIAImpactLow, CIAImpactHigh:
+		*c = impact
+		return nil
+	default:
+		return fmt.Errorf("nvd: unknown C/I/A impact %q", raw)
+	}
+}
+
+// Severity returns the CVE's severity rating, preferring the CVSS v3.1
+// baseSeverity when present and falling back to the legacy v2 rating.
+func (cve CVEItem) Severity() (Severity, bool) {
+	if v3 := cve.Impact.BaseMetricV3.CvssV3.BaseSeverity; v3 != "" {
+		return v3, true
+	}
+	switch v2 := cve.Impact.BaseMetricV2.Severity; v2 {
+	case "LOW":
+		return SeverityLow, true
+	case "MEDIUM":
+		return SeverityMedium, true
+	case "HIGH":
+		return SeverityHigh, true
+	}
+	return "", false
+}
@@ -0,0 +1,56 @@
+// Package osv defines the subset of the OSV schema
+// (https://ossf.github.io/osv-schema/) that nvdnotifier can populate from
+// an NVD CVE record, so downstream consumers like govulncheck, trivy, and
+// grype can ingest NVD data without speaking its native format.
+package osv
+
+// Entry is an OSV vulnerability record.
+type Entry struct {
+	SchemaVersion string      `json:"schema_version"`
+	ID            string      `json:"id"`
+	Summary       string      `json:"summary,omitempty"`
+	Details       string      `json:"details,omitempty"`
+	Published     string      `json:"published,omitempty"`
+	Modified      string      `json:"modified,omitempty"`
+	Severity      []Severity  `json:"severity,omitempty"`
+	Affected      []Affected  `json:"affected,omitempty"`
+	References    []Reference `json:"references,omitempty"`
+}
+
+// Severity carries a CVSS vector under OSV's severity.type discriminator.
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected describes one affected package and the version ranges where the
+// vulnerability applies.
+type Affected struct {
+	Package Package `json:"package"`
+	Ranges  []Range `json:"ranges,omitempty"`
+}
+
+// Package identifies the affected package within an ecosystem.
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+}
+
+// Range is a set of version events bounding the affected range. Type is
+// typically "SEMVER" or "ECOSYSTEM".
+type Range struct {
+	Type   string  `json:"type"`
+	Events []Event `json:"events"`
+}
+
+// Event is one bound of a Range: exactly one field is set.
+type Event struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// Reference is a link related to the vulnerability.
+type Reference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
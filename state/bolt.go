@@ -0,0 +1,93 @@
+package state
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+var seenBucket = []byte("seen")
+
+// BoltStore persists seen hashes in a bbolt (an embedded key/value store)
+// file, for single-process deployments that want something sturdier than a
+// JSON file without running a separate database.
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// OpenBoltStore opens (creating if needed) a BoltStore backed by path.
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(seenBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying bbolt file.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) Seen(id string) (prevHash string, ok bool) {
+	_ = s.db.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket(seenBucket).Get([]byte(id))
+		if v == nil {
+			return nil
+		}
+		var e entry
+		if err := json.Unmarshal(v, &e); err != nil {
+			return err
+		}
+		prevHash, ok = e.Hash, true
+		return nil
+	})
+	return prevHash, ok
+}
+
+func (s *BoltStore) Mark(id, hash string) error {
+	v, err := json.Marshal(entry{Hash: hash, LastSeen: time.Now()})
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(seenBucket).Put([]byte(id), v)
+	})
+}
+
+func (s *BoltStore) Prune(before time.Time) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(seenBucket)
+
+		var stale [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			var e entry
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			if e.LastSeen.Before(before) {
+				stale = append(stale, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range stale {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
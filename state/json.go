@@ -0,0 +1,76 @@
+package state
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// JSONStore persists seen hashes to a single JSON file. It's meant for
+// small, single-process deployments; every Mark/Prune rewrites the whole
+// file.
+type JSONStore struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string]entry
+}
+
+// OpenJSONStore loads (or creates) a JSONStore backed by path.
+func OpenJSONStore(path string) (*JSONStore, error) {
+	s := &JSONStore{path: path, entries: map[string]entry{}}
+
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return s, nil
+	}
+	if err := json.Unmarshal(b, &s.entries); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *JSONStore) Seen(id string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[id]
+	return e.Hash, ok
+}
+
+func (s *JSONStore) Mark(id, hash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[id] = entry{Hash: hash, LastSeen: time.Now()}
+	return s.save()
+}
+
+func (s *JSONStore) Prune(before time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, e := range s.entries {
+		if e.LastSeen.Before(before) {
+			delete(s.entries, id)
+		}
+	}
+	return s.save()
+}
+
+// save must be called with s.mu held.
+func (s *JSONStore) save() error {
+	b, err := json.MarshalIndent(s.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
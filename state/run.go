@@ -0,0 +1,73 @@
+package state
+
+import (
+	"context"
+
+	"github.com/jadkorr/nvdnotifier/notify"
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// EventType classifies why a CVE was included in a Run's output.
+type EventType string
+
+const (
+	// Added means the CVE has never been recorded in the Store before.
+	Added EventType = "added"
+	// Modified means the CVE was recorded before under a different hash.
+	Modified EventType = "modified"
+)
+
+// Event pairs a CVEItem with why it was surfaced.
+type Event struct {
+	Type EventType
+	Item nvd.CVEItem
+}
+
+// Run diffs items against store, notifies notifier of anything new or
+// changed, and only then marks those items seen in store — so a crash
+// between Notify and Mark causes a redelivery next run rather than a
+// silently dropped one. It returns the events it found, whether or not
+// there was anything to notify.
+func Run(ctx context.Context, store Store, notifier notify.Notifier, items []nvd.CVEItem) ([]Event, error) {
+	var events []Event
+	var changed []nvd.CVEItem
+
+	for _, item := range items {
+		hash, err := item.Hash()
+		if err != nil {
+			return nil, err
+		}
+
+		id := item.CVE.CVEDataMeta.ID
+		prev, ok := store.Seen(id)
+		switch {
+		case !ok:
+			events = append(events, Event{Type: Added, Item: item})
+		case prev != hash:
+			events = append(events, Event{Type: Modified, Item: item})
+		default:
+			continue
+		}
+		changed = append(changed, item)
+	}
+
+	if len(changed) == 0 {
+		return events, nil
+	}
+
+	if err := notifier.Notify(ctx, changed); err != nil {
+		return events, err
+	}
+
+	for _, item := range changed {
+		hash, err := item.Hash()
+		if err != nil {
+			return events, err
+		}
+		if err := store.Mark(item.CVE.CVEDataMeta.ID, hash); err != nil {
+			return events, err
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,123 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jadkorr/nvdnotifier/nvd"
+)
+
+// memStore is a minimal in-memory Store for exercising Run without a real
+// storage backend.
+type memStore struct {
+	seen map[string]string
+}
+
+func newMemStore() *memStore { return &memStore{seen: map[string]string{}} }
+
+func (s *memStore) Seen(id string) (string, bool) {
+	h, ok := s.seen[id]
+	return h, ok
+}
+
+func (s *memStore) Mark(id, hash string) error {
+	s.seen[id] = hash
+	return nil
+}
+
+func (s *memStore) Prune(before time.Time) error { return nil }
+
+// fakeNotifier records every batch it's asked to deliver, optionally
+// failing on command.
+type fakeNotifier struct {
+	batches [][]nvd.CVEItem
+	err     error
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, items []nvd.CVEItem) error {
+	n.batches = append(n.batches, items)
+	return n.err
+}
+
+func cveItem(id, description string) nvd.CVEItem {
+	item := nvd.CVEItem{}
+	item.CVE.CVEDataMeta = nvd.DataMeta{ID: id}
+	item.CVE.Description.DescriptionData = []nvd.Description{{Lang: "en", Value: description}}
+	return item
+}
+
+func TestRunNotifiesAndMarksNewItems(t *testing.T) {
+	store := newMemStore()
+	notifier := &fakeNotifier{}
+
+	events, err := Run(context.Background(), store, notifier, []nvd.CVEItem{cveItem("CVE-2024-0001", "first")})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != Added {
+		t.Fatalf("events = %+v, want one Added event", events)
+	}
+	if len(notifier.batches) != 1 || len(notifier.batches[0]) != 1 {
+		t.Fatalf("notifier.batches = %+v, want one batch of one item", notifier.batches)
+	}
+	if _, ok := store.Seen("CVE-2024-0001"); !ok {
+		t.Error("store didn't record CVE-2024-0001 after a successful Notify")
+	}
+}
+
+func TestRunSkipsUnchangedItems(t *testing.T) {
+	store := newMemStore()
+	item := cveItem("CVE-2024-0001", "first")
+	hash, err := item.Hash()
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	store.Mark("CVE-2024-0001", hash)
+
+	notifier := &fakeNotifier{}
+	events, err := Run(context.Background(), store, notifier, []nvd.CVEItem{item})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("events = %+v, want none for an unchanged item", events)
+	}
+	if len(notifier.batches) != 0 {
+		t.Errorf("notifier.batches = %+v, want no Notify call for an unchanged item", notifier.batches)
+	}
+}
+
+func TestRunReportsModifiedItems(t *testing.T) {
+	store := newMemStore()
+	original := cveItem("CVE-2024-0001", "first")
+	hash, err := original.Hash()
+	if err != nil {
+		t.Fatalf("Hash: %v", err)
+	}
+	store.Mark("CVE-2024-0001", hash)
+
+	updated := cveItem("CVE-2024-0001", "updated description")
+	notifier := &fakeNotifier{}
+	events, err := Run(context.Background(), store, notifier, []nvd.CVEItem{updated})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != Modified {
+		t.Fatalf("events = %+v, want one Modified event", events)
+	}
+}
+
+func TestRunLeavesStoreUnmarkedWhenNotifyFails(t *testing.T) {
+	store := newMemStore()
+	notifier := &fakeNotifier{err: errors.New("webhook down")}
+
+	_, err := Run(context.Background(), store, notifier, []nvd.CVEItem{cveItem("CVE-2024-0001", "first")})
+	if err == nil {
+		t.Fatal("Run returned nil error, want the notifier's error")
+	}
+	if _, ok := store.Seen("CVE-2024-0001"); ok {
+		t.Error("store marked CVE-2024-0001 as seen despite a failed Notify")
+	}
+}
@@ -0,0 +1,58 @@
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteStore persists seen hashes in a SQLite database, for deployments
+// that already run SQLite elsewhere or want to query seen state with SQL.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// OpenSQLiteStore opens (creating if needed) a SQLiteStore backed by path.
+func OpenSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	const schema = `CREATE TABLE IF NOT EXISTS seen (
+		id TEXT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		last_seen TIMESTAMP NOT NULL
+	)`
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &SQLiteStore{db: db}, nil
+}
+
+// Close releases the underlying database connection.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) Seen(id string) (prevHash string, ok bool) {
+	err := s.db.QueryRow(`SELECT hash FROM seen WHERE id = ?`, id).Scan(&prevHash)
+	if err != nil {
+		return "", false
+	}
+	return prevHash, true
+}
+
+func (s *SQLiteStore) Mark(id, hash string) error {
+	_, err := s.db.Exec(`
+		INSERT INTO seen (id, hash, last_seen) VALUES (?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET hash = excluded.hash, last_seen = excluded.last_seen`,
+		id, hash, time.Now())
+	return err
+}
+
+func (s *SQLiteStore) Prune(before time.Time) error {
+	_, err := s.db.Exec(`DELETE FROM seen WHERE last_seen < ?`, before)
+	return err
+}
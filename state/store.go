@@ -0,0 +1,27 @@
+// Package state remembers which CVEs have already been reported, so a
+// notifier run only has to act on what's new or changed since last time.
+package state
+
+import "time"
+
+// Store remembers the last-notified hash (see nvd.CVEItem.Hash) for each
+// CVE ID. Implementations must make Mark visible to a subsequent Seen
+// before Mark returns, including across process restarts.
+type Store interface {
+	// Seen returns the hash last recorded for id, and whether one exists.
+	// A storage-layer failure is indistinguishable from "not seen" through
+	// this signature; implementations should log such failures themselves
+	// since Run will otherwise just treat the CVE as new.
+	Seen(id string) (prevHash string, ok bool)
+	// Mark records hash as the latest seen value for id.
+	Mark(id, hash string) error
+	// Prune drops entries not marked since before, so the store doesn't
+	// grow forever as old CVEs stop appearing in feeds.
+	Prune(before time.Time) error
+}
+
+// entry is the value stored per CVE ID across all backends.
+type entry struct {
+	Hash     string    `json:"hash"`
+	LastSeen time.Time `json:"last_seen"`
+}